@@ -0,0 +1,67 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	cmdCreateKey = &cobra.Command{
+		Use:   "create-key",
+		Short: "Add a Packet SSH key",
+		RunE:  runCreateKey,
+	}
+
+	createKeyLabel         string
+	createKeyPublicKeyFile string
+)
+
+func init() {
+	cmdCreateKey.Flags().StringVar(&createKeyLabel, "label", "", "label for the new key")
+	cmdCreateKey.Flags().StringVar(&createKeyPublicKeyFile, "public-key-file", "", "path to the public key to upload")
+	Packet.AddCommand(cmdCreateKey)
+}
+
+func runCreateKey(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("Unrecognized args in packet create-key cmd: %v", args)
+	}
+
+	if createKeyLabel == "" {
+		return usageErrorf("--label is required")
+	}
+	if createKeyPublicKeyFile == "" {
+		return usageErrorf("--public-key-file is required")
+	}
+
+	publicKey, err := ioutil.ReadFile(createKeyPublicKeyFile)
+	if err != nil {
+		return fmt.Errorf("couldn't read %q: %v", createKeyPublicKeyFile, err)
+	}
+
+	key, err := API.CreateKey(createKeyLabel, string(publicKey))
+	if err != nil {
+		return fmt.Errorf("couldn't create key: %v", err)
+	}
+
+	if err := printKey(*key, key.ID); err != nil {
+		return fmt.Errorf("couldn't render key: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	cmdDeleteKey = &cobra.Command{
+		Use:   "delete-key",
+		Short: "Delete a Packet SSH key",
+		RunE:  runDeleteKey,
+	}
+
+	deleteKeyID    string
+	deleteKeyLabel string
+)
+
+func init() {
+	cmdDeleteKey.Flags().StringVar(&deleteKeyID, "id", "", "id of the key to delete")
+	cmdDeleteKey.Flags().StringVar(&deleteKeyLabel, "label", "", "label of the key to delete")
+	Packet.AddCommand(cmdDeleteKey)
+}
+
+func runDeleteKey(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("Unrecognized args in packet delete-key cmd: %v", args)
+	}
+
+	id, err := resolveKeyID(deleteKeyID, deleteKeyLabel)
+	if err != nil {
+		return err
+	}
+
+	if err := API.DeleteKey(id); err != nil {
+		return fmt.Errorf("couldn't delete key: %v", err)
+	}
+
+	return nil
+}
+
+// resolveKeyID maps an --id/--label pair from a packet subcommand down to a
+// single key ID, looking the key up by label if necessary.
+func resolveKeyID(id, label string) (string, error) {
+	if id == "" && label == "" {
+		return "", usageErrorf("one of --id or --label is required")
+	}
+	if id != "" && label != "" {
+		return "", usageErrorf("only one of --id or --label may be given")
+	}
+	if id != "" {
+		return id, nil
+	}
+
+	key, err := API.FindKeyByLabel(label)
+	if err != nil {
+		return "", err
+	}
+	return key.ID, nil
+}
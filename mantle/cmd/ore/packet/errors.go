@@ -0,0 +1,51 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"errors"
+	"fmt"
+	"os"
+)
+
+// UsageError indicates invalid command-line arguments. Execute maps it to
+// exit code 2, matching cobra's own usage-error convention.
+type UsageError struct {
+	msg string
+}
+
+func (e *UsageError) Error() string {
+	return e.msg
+}
+
+func usageErrorf(format string, args ...interface{}) error {
+	return &UsageError{msg: fmt.Sprintf(format, args...)}
+}
+
+// Execute runs the packet command tree and translates any returned error
+// into a process exit code: UsageError becomes 2, any other error becomes 1.
+// This is the single place packet subcommands' errors turn into os.Exit
+// calls, so RunE handlers themselves can stay exit-free and testable.
+func Execute() {
+	if err := Packet.Execute(); err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+
+		var usageErr *UsageError
+		if errors.As(err, &usageErr) {
+			os.Exit(2)
+		}
+		os.Exit(1)
+	}
+}
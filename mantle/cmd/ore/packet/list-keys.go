@@ -16,9 +16,10 @@ package packet
 
 import (
 	"fmt"
-	"os"
 
 	"github.com/spf13/cobra"
+
+	"github.com/coreos/mantle/platform/api/packet"
 )
 
 var (
@@ -27,26 +28,40 @@ var (
 		Short: "List Packet SSH keys",
 		RunE:  runListKeys,
 	}
+
+	listKeysLabelPattern string
+	listKeysFingerprint  string
+	listKeysLimit        int
+	listKeysPage         int
 )
 
 func init() {
+	cmdListKeys.Flags().StringVar(&listKeysLabelPattern, "label-pattern", "", "only list keys whose label matches this glob")
+	cmdListKeys.Flags().StringVar(&listKeysFingerprint, "fingerprint", "", "only list the key with this fingerprint")
+	cmdListKeys.Flags().IntVar(&listKeysLimit, "limit", 0, "page size requested from the Packet API")
+	cmdListKeys.Flags().IntVar(&listKeysPage, "page", 0, "fetch only this page instead of all pages")
 	Packet.AddCommand(cmdListKeys)
 }
 
 func runListKeys(cmd *cobra.Command, args []string) error {
 	if len(args) != 0 {
-		fmt.Fprintf(os.Stderr, "Unrecognized args in packet list-keys cmd: %v\n", args)
-		os.Exit(2)
+		return usageErrorf("Unrecognized args in packet list-keys cmd: %v", args)
 	}
 
-	keys, err := API.ListKeys()
+	keysCh, errc := API.ListKeys(packet.ListOptions{
+		LabelPattern: listKeysLabelPattern,
+		Fingerprint:  listKeysFingerprint,
+		Limit:        listKeysLimit,
+		Page:         listKeysPage,
+	})
+
+	keys, err := drainKeys(keysCh, errc)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Couldn't list keys: %v\n", err)
-		os.Exit(1)
+		return fmt.Errorf("couldn't list keys: %v", err)
 	}
 
-	for _, key := range keys {
-		fmt.Println(key.Label)
+	if err := printKeys(keys); err != nil {
+		return fmt.Errorf("couldn't render keys: %v", err)
 	}
 	return nil
 }
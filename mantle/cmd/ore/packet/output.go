@@ -0,0 +1,117 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/coreos/mantle/platform/api/packet"
+)
+
+var outputFormat string
+
+func init() {
+	Packet.PersistentFlags().StringVar(&outputFormat, "output", "plain", "output format: plain, json, yaml, or table")
+}
+
+// keyRecord is the structured representation of a Packet SSH key rendered
+// by --output json/yaml/table.
+type keyRecord struct {
+	ID          string `json:"id" yaml:"id"`
+	Label       string `json:"label" yaml:"label"`
+	Fingerprint string `json:"fingerprint" yaml:"fingerprint"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+}
+
+func newKeyRecord(key packet.Key) keyRecord {
+	return keyRecord{
+		ID:          key.ID,
+		Label:       key.Label,
+		Fingerprint: key.Fingerprint,
+		CreatedAt:   key.CreatedAt,
+	}
+}
+
+// drainKeys collects every key off a ListKeys channel pair into a slice,
+// returning the first error encountered, if any.
+func drainKeys(keysCh <-chan packet.Key, errc <-chan error) ([]packet.Key, error) {
+	var keys []packet.Key
+	for k := range keysCh {
+		keys = append(keys, k)
+	}
+	if err := <-errc; err != nil {
+		return nil, err
+	}
+	return keys, nil
+}
+
+// printKeys renders keys to stdout according to outputFormat. plain preserves
+// the historical label-only behavior of `packet list-keys`.
+func printKeys(keys []packet.Key) error {
+	if outputFormat == "plain" {
+		for _, key := range keys {
+			fmt.Println(key.Label)
+		}
+		return nil
+	}
+
+	records := make([]keyRecord, 0, len(keys))
+	for _, key := range keys {
+		records = append(records, newKeyRecord(key))
+	}
+	return printRecords(records)
+}
+
+// printKey renders a single key to stdout according to outputFormat.
+// plainText is used verbatim for the "plain" format, so callers can keep
+// their own pre-existing plain-text rendering (e.g. create-key's bare ID,
+// show-key's multi-line summary) while gaining json/yaml/table support.
+func printKey(key packet.Key, plainText string) error {
+	if outputFormat == "plain" {
+		fmt.Println(plainText)
+		return nil
+	}
+	return printRecords([]keyRecord{newKeyRecord(key)})
+}
+
+func printRecords(records []keyRecord) error {
+	switch outputFormat {
+	case "json":
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case "yaml":
+		out, err := yaml.Marshal(records)
+		if err != nil {
+			return fmt.Errorf("marshaling records to yaml: %v", err)
+		}
+		fmt.Print(string(out))
+		return nil
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tLABEL\tFINGERPRINT\tCREATED AT")
+		for _, r := range records {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.ID, r.Label, r.Fingerprint, r.CreatedAt)
+		}
+		return w.Flush()
+	default:
+		return fmt.Errorf("unknown output format %q", outputFormat)
+	}
+}
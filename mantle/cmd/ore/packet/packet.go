@@ -0,0 +1,54 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/coreos/mantle/platform/api/packet"
+)
+
+var (
+	Packet = &cobra.Command{
+		Use:   "packet [command]",
+		Short: "packet image and vm utilities",
+	}
+
+	API *packet.API
+
+	apiKey  string
+	project string
+)
+
+func init() {
+	Packet.PersistentFlags().StringVar(&apiKey, "packet-key", os.Getenv("PACKET_API_KEY"), "Packet API key")
+	Packet.PersistentFlags().StringVar(&project, "packet-project", os.Getenv("PACKET_PROJECT"), "Packet project ID")
+	Packet.PersistentPreRunE = preRun
+}
+
+func preRun(cmd *cobra.Command, args []string) error {
+	var err error
+	API, err = packet.New(&packet.Options{
+		ApiKey:  apiKey,
+		Project: project,
+	})
+	if err != nil {
+		return fmt.Errorf("setting up Packet client: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/chzyer/readline"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	cmdShell = &cobra.Command{
+		Use:   "shell",
+		Short: "Start an interactive packet shell",
+		RunE:  runShell,
+	}
+)
+
+// shellState holds the session state a `use` command can change, so it
+// survives across commands typed into the shell.
+type shellState struct {
+	project string
+}
+
+func init() {
+	Packet.AddCommand(cmdShell)
+}
+
+func runShell(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("Unrecognized args in packet shell cmd: %v", args)
+	}
+
+	state := &shellState{project: project}
+
+	completer := readline.NewPrefixCompleter(shellCompletionItems()...)
+	rl, err := readline.NewEx(&readline.Config{
+		Prompt:          state.prompt(),
+		HistoryFile:     "",
+		AutoComplete:    completer,
+		InterruptPrompt: "^C",
+		EOFPrompt:       "exit",
+	})
+	if err != nil {
+		return fmt.Errorf("starting shell: %v", err)
+	}
+	defer rl.Close()
+
+	for {
+		rl.SetPrompt(state.prompt())
+		line, err := rl.Readline()
+		if err == readline.ErrInterrupt {
+			continue
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("reading shell input: %v", err)
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "exit", "quit":
+			return nil
+		case "use":
+			if err := state.handleUse(fields[1:]); err != nil {
+				fmt.Println(err)
+			}
+			continue
+		case "shell":
+			fmt.Println("already in a packet shell")
+			continue
+		}
+
+		if err := dispatchShellCommand(fields); err != nil {
+			fmt.Println(err)
+		}
+	}
+}
+
+func (s *shellState) prompt() string {
+	return fmt.Sprintf("packet(project=%s)> ", s.project)
+}
+
+func (s *shellState) handleUse(args []string) error {
+	if len(args) != 2 || args[0] != "project" {
+		return usageErrorf("usage: use project <id>")
+	}
+	s.project = args[1]
+	project = args[1]
+	return preRun(Packet, nil)
+}
+
+// dispatchShellCommand looks up the subcommand named by the first token
+// typed at the shell prompt and runs it directly against the already
+// initialized API client. Unlike replaying Packet.Execute(), this skips
+// Packet.PersistentPreRunE (so it doesn't rebuild the API client, and thus
+// doesn't re-authenticate, on every line) and resets the subcommand's own
+// flags to their defaults before parsing, so a value set on one shell
+// command doesn't silently linger into a later invocation of the same
+// subcommand that omits it.
+func dispatchShellCommand(args []string) error {
+	cmd, rest, err := Packet.Find(args)
+	if err != nil {
+		return fmt.Errorf("unknown command: %v", err)
+	}
+	if cmd == Packet {
+		return fmt.Errorf("unknown command: %v", args[0])
+	}
+
+	resetFlags(cmd)
+	if err := cmd.ParseFlags(rest); err != nil {
+		return err
+	}
+	if cmd.RunE == nil {
+		return usageErrorf("command %q takes no direct action", cmd.Name())
+	}
+	return cmd.RunE(cmd, cmd.Flags().Args())
+}
+
+// resetFlags restores cmd's own flags to their default values so repeated
+// shell invocations of the same subcommand start from a clean slate. It
+// skips flags inherited from Packet's persistent flag set: cobra's
+// ParseFlags merges those in by reference on first use and never undoes
+// the merge, so resetting them here would stomp session state (--output,
+// --packet-key, --packet-project, and anything `use project` changed)
+// every time the same subcommand ran a second time.
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if Packet.PersistentFlags().Lookup(f.Name) != nil {
+			return
+		}
+		f.Value.Set(f.DefValue)
+		f.Changed = false
+	})
+}
+
+// shellCompletionItems builds tab-completion entries for every registered
+// packet subcommand, reusing cobra's own command tree instead of a
+// hand-maintained list.
+func shellCompletionItems() []readline.PrefixCompleterInterface {
+	var items []readline.PrefixCompleterInterface
+	for _, cmd := range Packet.Commands() {
+		items = append(items, readline.PcItem(cmd.Name()))
+	}
+	items = append(items, readline.PcItem("use", readline.PcItem("project")))
+	return items
+}
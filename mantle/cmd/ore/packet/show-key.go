@@ -0,0 +1,61 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	cmdShowKey = &cobra.Command{
+		Use:   "show-key",
+		Short: "Show a Packet SSH key",
+		RunE:  runShowKey,
+	}
+
+	showKeyID    string
+	showKeyLabel string
+)
+
+func init() {
+	cmdShowKey.Flags().StringVar(&showKeyID, "id", "", "id of the key to show")
+	cmdShowKey.Flags().StringVar(&showKeyLabel, "label", "", "label of the key to show")
+	Packet.AddCommand(cmdShowKey)
+}
+
+func runShowKey(cmd *cobra.Command, args []string) error {
+	if len(args) != 0 {
+		return usageErrorf("Unrecognized args in packet show-key cmd: %v", args)
+	}
+
+	id, err := resolveKeyID(showKeyID, showKeyLabel)
+	if err != nil {
+		return err
+	}
+
+	key, err := API.GetKey(id)
+	if err != nil {
+		return fmt.Errorf("couldn't show key: %v", err)
+	}
+
+	plainText := fmt.Sprintf("ID:          %s\nLabel:       %s\nFingerprint: %s\nPublic Key:  %s",
+		key.ID, key.Label, key.Fingerprint, key.PublicKey)
+	if err := printKey(*key, plainText); err != nil {
+		return fmt.Errorf("couldn't render key: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+// Copyright 2017 CoreOS, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packet
+
+import (
+	"fmt"
+	"path"
+
+	"github.com/packethost/packngo"
+)
+
+// defaultPageSize is used when ListOptions.Limit is left unset.
+const defaultPageSize = 100
+
+// Options holds the configuration needed to talk to the Packet API.
+type Options struct {
+	ApiKey  string
+	Project string
+}
+
+// API wraps a Packet client scoped to a single project.
+type API struct {
+	client  *packngo.Client
+	project string
+}
+
+// Key is the subset of a Packet SSH key that ore cares about.
+type Key struct {
+	ID          string
+	Label       string
+	Fingerprint string
+	PublicKey   string
+	CreatedAt   string
+}
+
+// New creates a Packet API client from the given options.
+func New(opts *Options) (*API, error) {
+	if opts.ApiKey == "" {
+		return nil, fmt.Errorf("missing Packet API key")
+	}
+	if opts.Project == "" {
+		return nil, fmt.Errorf("missing Packet project")
+	}
+
+	client := packngo.NewClient("", opts.ApiKey, nil)
+
+	return &API{
+		client:  client,
+		project: opts.Project,
+	}, nil
+}
+
+// ListOptions filters and paginates a ListKeys call. A zero value lists
+// every key across all pages.
+type ListOptions struct {
+	// LabelPattern is a path.Match glob applied to each key's label.
+	LabelPattern string
+	// Fingerprint, if set, restricts results to keys with an exact match.
+	Fingerprint string
+	// Limit is the page size requested from the Packet API.
+	Limit int
+	// Page, if non-zero, fetches only that single page instead of
+	// following pagination to the end of the result set.
+	Page int
+}
+
+// ListKeys streams the SSH keys matching opts over the returned channel,
+// paging through the Packet API as needed so callers working with large
+// key sets don't have to buffer the whole result in memory. The error
+// channel receives at most one value and is closed once keys is closed.
+func (a *API) ListKeys(opts ListOptions) (<-chan Key, <-chan error) {
+	keys := make(chan Key)
+	errc := make(chan error, 1)
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultPageSize
+	}
+	page := opts.Page
+	if page <= 0 {
+		page = 1
+	}
+
+	go func() {
+		defer close(keys)
+		defer close(errc)
+
+		for {
+			sshKeys, _, err := a.client.SSHKeys.List(&packngo.ListOptions{
+				Page:    page,
+				PerPage: limit,
+			})
+			if err != nil {
+				errc <- fmt.Errorf("listing SSH keys: %v", err)
+				return
+			}
+
+			for _, k := range sshKeys {
+				if opts.Fingerprint != "" && k.FingerPrint != opts.Fingerprint {
+					continue
+				}
+				if opts.LabelPattern != "" {
+					matched, err := path.Match(opts.LabelPattern, k.Label)
+					if err != nil {
+						errc <- fmt.Errorf("invalid label pattern %q: %v", opts.LabelPattern, err)
+						return
+					}
+					if !matched {
+						continue
+					}
+				}
+
+				keys <- Key{
+					ID:          k.ID,
+					Label:       k.Label,
+					Fingerprint: k.FingerPrint,
+					PublicKey:   k.Key,
+					CreatedAt:   k.Created,
+				}
+			}
+
+			if opts.Page != 0 || len(sshKeys) < limit {
+				return
+			}
+			page++
+		}
+	}()
+
+	return keys, errc
+}
+
+// CreateKey registers a new SSH key with the given label and public key body.
+func (a *API) CreateKey(label, publicKey string) (*Key, error) {
+	sshKey, _, err := a.client.SSHKeys.Create(&packngo.SSHKeyCreateRequest{
+		Label: label,
+		Key:   publicKey,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("creating SSH key %q: %v", label, err)
+	}
+
+	return &Key{
+		ID:          sshKey.ID,
+		Label:       sshKey.Label,
+		Fingerprint: sshKey.FingerPrint,
+		PublicKey:   sshKey.Key,
+		CreatedAt:   sshKey.Created,
+	}, nil
+}
+
+// DeleteKey removes the SSH key with the given ID.
+func (a *API) DeleteKey(id string) error {
+	_, err := a.client.SSHKeys.Delete(id)
+	if err != nil {
+		return fmt.Errorf("deleting SSH key %q: %v", id, err)
+	}
+	return nil
+}
+
+// GetKey looks up a single SSH key by ID.
+func (a *API) GetKey(id string) (*Key, error) {
+	sshKey, _, err := a.client.SSHKeys.Get(id)
+	if err != nil {
+		return nil, fmt.Errorf("getting SSH key %q: %v", id, err)
+	}
+
+	return &Key{
+		ID:          sshKey.ID,
+		Label:       sshKey.Label,
+		Fingerprint: sshKey.FingerPrint,
+		PublicKey:   sshKey.Key,
+		CreatedAt:   sshKey.Created,
+	}, nil
+}
+
+// FindKeyByLabel returns the first key with an exact match on the given
+// label, if any. Unlike ListOptions.LabelPattern, label is compared
+// verbatim and is never interpreted as a glob, since Packet key labels are
+// free-form and may themselves contain glob metacharacters.
+func (a *API) FindKeyByLabel(label string) (*Key, error) {
+	keysCh, errc := a.ListKeys(ListOptions{})
+
+	// Drain keysCh to completion even after a match, rather than returning
+	// early: the ListKeys producer goroutine blocks sending on keysCh until
+	// it's read, so an early return would leak it for the rest of the
+	// process (or, inside `packet shell`, for the rest of the session).
+	var found *Key
+	for k := range keysCh {
+		if found == nil && k.Label == label {
+			match := k
+			found = &match
+		}
+	}
+	if err := <-errc; err != nil {
+		return nil, err
+	}
+	if found != nil {
+		return found, nil
+	}
+	return nil, fmt.Errorf("no SSH key found with label %q", label)
+}